@@ -0,0 +1,47 @@
+package timer
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Clock abstracts the parts of the standard time package that Timer and
+// Runner depend on, so that callers can inject deterministic implementations
+// in tests instead of relying on process-global stand-ins.
+type Clock interface {
+	// After waits for the duration to elapse and then sends the current time
+	// on the returned channel. It behaves like time.After.
+	After(d time.Duration) <-chan time.Time
+	// Now returns the current time. It behaves like time.Now.
+	Now() time.Time
+}
+
+// realClock is the default Clock, backed by the standard time package.
+type realClock struct{}
+
+// NewRealClock returns a Clock backed by the standard time package. It is the
+// default Clock used by a Timer or Runner unless overridden with WithClock.
+func NewRealClock() Clock {
+	return realClock{}
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// magnitude returns a uniformly random number in the range [-1.0, 1.0). It is
+// stored per-Timer rather than as a process global so that tests do not need
+// to swap and restore shared state.
+func magnitude() float64 {
+	return 1.0 - 2.0*rand.Float64()
+}
+
+// uniform returns a uniformly random number in the range [0.0, 1.0). Like
+// magnitude, it is stored per-Timer rather than as a process global.
+func uniform() float64 {
+	return rand.Float64()
+}
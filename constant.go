@@ -11,6 +11,10 @@ func (c constant) next() time.Duration {
 func (constant) reset() {
 }
 
+func (c constant) base() time.Duration {
+	return time.Duration(c)
+}
+
 // NewConstant returns a constant timer, functionally equivalent to the standard
 // library time.Timer.
 func NewConstant(interval time.Duration) *Timer {
@@ -170,6 +170,26 @@ func ExampleTimer_Reset() {
 	// 16ms
 }
 
+func ExampleTimer_Ticker() {
+	con := timer.NewConstant(time.Millisecond)
+	c, _, stop := con.Ticker()
+	defer stop()
+
+	then := time.Now()
+	for i := 0; i < 5; i++ {
+		now := <-c
+		fmt.Println(now.Sub(then).Round(time.Millisecond))
+		then = now
+	}
+
+	// Output:
+	// 1ms
+	// 1ms
+	// 1ms
+	// 1ms
+	// 1ms
+}
+
 func ExampleTimer_Stop() {
 	con := timer.NewConstant(time.Millisecond)
 	fmt.Println("timer was running:", con.Stop())
@@ -24,6 +24,10 @@ func (e *exponential) reset() {
 	e.current = e.initial
 }
 
+func (e *exponential) base() time.Duration {
+	return e.initial
+}
+
 // NewExponential returns an exponential backoff timer.
 func NewExponential(initial time.Duration, multiplier float32) *Timer {
 	return newTimer(&exponential{
@@ -0,0 +1,74 @@
+package timer
+
+import "time"
+
+// JitterMode selects the algorithm used to add jitter to a timer's computed
+// interval.
+type JitterMode int
+
+const (
+	// JitterNone applies the simple fractional jitter configured via
+	// WithJitter, if any. It is the zero value, and the default for a new
+	// Timer.
+	JitterNone JitterMode = iota
+	// JitterFull returns a uniformly random duration in [0, min(cap, next)),
+	// where next is the timer's deterministic next interval.
+	JitterFull
+	// JitterEqual returns next/2 plus a uniformly random duration in
+	// [0, next/2), where next is the timer's deterministic next interval.
+	JitterEqual
+	// JitterDecorrelated returns a uniformly random duration in
+	// [base, prev*3), capped at cap, where base is the timer's initial
+	// interval and prev is the duration returned by the previous call. It is
+	// seeded from base on the first call. Unlike the other modes, it ignores
+	// the timer's deterministic schedule in favour of its own state, and so
+	// is best suited to timers created with NewConstant.
+	JitterDecorrelated
+)
+
+// WithJitterMode selects an alternative jitter algorithm to the fractional
+// jitter applied by WithJitter. cap bounds the maximum jittered interval; it
+// is ignored by JitterNone. Full, equal, and decorrelated jitter avoid the
+// synchronized retries that symmetric jitter can cause under contention.
+func (t *Timer) WithJitterMode(mode JitterMode, cap time.Duration) *Timer {
+	t.jitterMode = mode
+	t.jitterCap = cap
+	return t
+}
+
+// applyJitter returns next, or a jittered alternative to it, depending on the
+// timer's configured jitter mode.
+func (t *Timer) applyJitter(next time.Duration) time.Duration {
+	switch t.jitterMode {
+	case JitterFull:
+		ceiling := next
+		if t.jitterCap > 0 && t.jitterCap < ceiling {
+			ceiling = t.jitterCap
+		}
+		if ceiling <= 0 {
+			return 0
+		}
+		return time.Duration(t.uniform() * float64(ceiling))
+	case JitterEqual:
+		half := next / 2
+		jittered := half + time.Duration(t.uniform()*float64(half))
+		if t.jitterCap > 0 && jittered > t.jitterCap {
+			jittered = t.jitterCap
+		}
+		return jittered
+	case JitterDecorrelated:
+		base := t.interval.base()
+		prev := t.jitterPrev
+		if prev == 0 {
+			prev = base
+		}
+		jittered := base + time.Duration(t.uniform()*float64(prev*3-base))
+		if t.jitterCap > 0 && jittered > t.jitterCap {
+			jittered = t.jitterCap
+		}
+		t.jitterPrev = jittered
+		return jittered
+	default:
+		return next + time.Duration(t.jitter*t.magnitude()*float64(next))
+	}
+}
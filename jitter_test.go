@@ -0,0 +1,117 @@
+package timer
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJitterFull(t *testing.T) {
+	fakeClock := newClock()
+	base := time.Second
+	cap := 500 * time.Millisecond
+
+	timer := NewConstant(base).WithClock(fakeClock).WithJitterMode(JitterFull, cap)
+	for i := 0; i < 1000; i++ {
+		c, err := timer.Start()
+		require.NoError(t, err)
+		assert.NotEmpty(t, <-c)
+		assert.GreaterOrEqual(t, fakeClock.next, time.Duration(0))
+		assert.Less(t, fakeClock.next, cap)
+	}
+}
+
+func TestJitterEqual(t *testing.T) {
+	fakeClock := newClock()
+	base := time.Second
+
+	timer := NewConstant(base).WithClock(fakeClock).WithJitterMode(JitterEqual, 0)
+	for i := 0; i < 1000; i++ {
+		c, err := timer.Start()
+		require.NoError(t, err)
+		assert.NotEmpty(t, <-c)
+		assert.GreaterOrEqual(t, fakeClock.next, base/2)
+		assert.Less(t, fakeClock.next, base)
+	}
+}
+
+func TestJitterDecorrelated(t *testing.T) {
+	fakeClock := newClock()
+	base := time.Second
+	cap := 10 * time.Second
+
+	timer := NewConstant(base).WithClock(fakeClock).WithJitterMode(JitterDecorrelated, cap)
+	for i := 0; i < 1000; i++ {
+		c, err := timer.Start()
+		require.NoError(t, err)
+		assert.NotEmpty(t, <-c)
+		assert.GreaterOrEqual(t, fakeClock.next, base)
+		assert.LessOrEqual(t, fakeClock.next, cap)
+	}
+}
+
+func TestJitterDecorrelated_DeterministicBounds(t *testing.T) {
+	fakeClock := newClock()
+	fakePRNG := newPRNG()
+	base := time.Second
+	cap := 10 * time.Second
+
+	timer := NewConstant(base).WithClock(fakeClock).WithJitterMode(JitterDecorrelated, cap)
+	timer.uniform = fakePRNG.Float64
+
+	// First call is seeded from base: [base, base*3).
+	fakePRNG.val = 0.0
+	c, err := timer.Start()
+	require.NoError(t, err)
+	assert.NotEmpty(t, <-c)
+	assert.Equal(t, base, fakeClock.next)
+
+	fakePRNG.val = 1.0
+	c, err = timer.Start()
+	require.NoError(t, err)
+	assert.NotEmpty(t, <-c)
+	assert.Equal(t, 3*base, fakeClock.next)
+
+	// Subsequent calls grow from the previous sleep until capped.
+	for i := 0; i < 10; i++ {
+		fakePRNG.val = 1.0
+		c, err = timer.Start()
+		require.NoError(t, err)
+		assert.NotEmpty(t, <-c)
+	}
+	assert.Equal(t, cap, fakeClock.next)
+}
+
+func TestJitterMode_RandomizedStatisticalBounds(t *testing.T) {
+	fakeClock := newClock()
+	base := time.Second
+
+	tests := []struct {
+		name string
+		mode JitterMode
+		cap  time.Duration
+		min  time.Duration
+		max  time.Duration
+	}{
+		{"full", JitterFull, 0, 0, base},
+		{"full-capped", JitterFull, base / 2, 0, base / 2},
+		{"equal", JitterEqual, 0, base / 2, base},
+		{"decorrelated-capped", JitterDecorrelated, 2 * base, base, 2 * base},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timer := NewConstant(base).WithClock(fakeClock).WithJitterMode(tt.mode, tt.cap)
+			timer.uniform = rand.Float64
+			for i := 0; i < 1000; i++ {
+				c, err := timer.Start()
+				require.NoError(t, err)
+				assert.NotEmpty(t, <-c)
+				assert.GreaterOrEqual(t, fakeClock.next, tt.min)
+				assert.LessOrEqual(t, fakeClock.next, tt.max)
+			}
+		})
+	}
+}
@@ -18,6 +18,10 @@ func (l *linear) reset() {
 	l.current = l.initial
 }
 
+func (l *linear) base() time.Duration {
+	return l.initial
+}
+
 // NewLinear returns a linear backoff timer.
 func NewLinear(initial, increment time.Duration) *Timer {
 	return newTimer(&linear{
@@ -0,0 +1,73 @@
+package timer
+
+import (
+	"context"
+	"time"
+)
+
+// RetryOption configures the behaviour of Retry.
+type RetryOption func(*retryConfig)
+
+type retryConfig struct {
+	isPermanent func(error) bool
+	onRetry     func(attempt int, err error, next time.Duration)
+}
+
+// WithPermanentError marks an error as permanent when pred returns true for
+// it. Retry returns a permanent error immediately, without retrying op
+// again.
+func WithPermanentError(pred func(error) bool) RetryOption {
+	return func(c *retryConfig) {
+		c.isPermanent = pred
+	}
+}
+
+// OnRetry registers a callback that is invoked after each failed, non-
+// permanent attempt, before Retry sleeps for the next interval. attempt is
+// the 1-based count of the attempt that just failed, err is the error it
+// returned, and next is the interval Retry will sleep for before retrying.
+func OnRetry(f func(attempt int, err error, next time.Duration)) RetryOption {
+	return func(c *retryConfig) {
+		c.onRetry = f
+	}
+}
+
+// Retry invokes op, retrying it on error until it succeeds, op returns a
+// permanent error, ctx is canceled, or t's configured maximum duration
+// elapses. Between attempts it sleeps for t's next interval via t.Start,
+// honoring any jitter, min/max interval, or max duration already configured
+// on t. Retry ties t's lifetime to ctx, so WithContext need not be called on
+// t separately.
+func Retry(ctx context.Context, t *Timer, op func(ctx context.Context) error, opts ...RetryOption) error {
+	var cfg retryConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	t = t.WithContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		err := op(ctx)
+		if err == nil {
+			return nil
+		}
+		if cfg.isPermanent != nil && cfg.isPermanent(err) {
+			return err
+		}
+
+		c, startErr := t.Start()
+		if startErr != nil {
+			return startErr
+		}
+
+		if cfg.onRetry != nil {
+			cfg.onRetry(attempt, err, t.LastInterval())
+		}
+
+		select {
+		case <-c:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
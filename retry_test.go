@@ -0,0 +1,89 @@
+package timer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetry_SucceedsFirstTry(t *testing.T) {
+	fakeClock := newClock()
+	timer := NewConstant(time.Second).WithClock(fakeClock)
+
+	calls := 0
+	err := Retry(context.Background(), timer, func(context.Context) error {
+		calls++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetry_EventualSuccess(t *testing.T) {
+	fakeClock := newClock()
+	timer := NewConstant(time.Second).WithClock(fakeClock)
+
+	var retries []int
+	calls := 0
+	err := Retry(context.Background(), timer, func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errors.New("not yet")
+		}
+		return nil
+	}, OnRetry(func(attempt int, err error, next time.Duration) {
+		retries = append(retries, attempt)
+		assert.Equal(t, time.Second, next)
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []int{1, 2}, retries)
+}
+
+func TestRetry_PermanentError(t *testing.T) {
+	fakeClock := newClock()
+	timer := NewConstant(time.Second).WithClock(fakeClock)
+	permanent := errors.New("permanent")
+
+	calls := 0
+	err := Retry(context.Background(), timer, func(context.Context) error {
+		calls++
+		return permanent
+	}, WithPermanentError(func(err error) bool { return errors.Is(err, permanent) }))
+	require.ErrorIs(t, err, permanent)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetry_MaxDurationElapsed(t *testing.T) {
+	fakeClock := newClock()
+	timer := NewConstant(time.Second).WithClock(fakeClock).WithMaxDuration(time.Second)
+
+	calls := 0
+	err := Retry(context.Background(), timer, func(context.Context) error {
+		calls++
+		return errors.New("still failing")
+	})
+	require.ErrorIs(t, err, ErrMaxDurationElapsed)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetry_ContextCanceled(t *testing.T) {
+	fakeClock := newClock()
+	fakeClock.fire = false
+	timer := NewConstant(time.Second).WithClock(fakeClock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := Retry(ctx, timer, func(context.Context) error {
+		calls++
+		return errors.New("failing")
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,140 @@
+package timer
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Runner invokes a function on demand, no more often than every minInterval,
+// and no less often than every maxInterval. Calls to Run that arrive while an
+// invocation is already pending are coalesced into that single pending
+// invocation.
+type Runner struct {
+	ctx         context.Context
+	clock       Clock
+	fn          func()
+	minInterval time.Duration
+	maxInterval time.Duration
+	run         chan struct{}
+	stop        chan struct{}
+	once        sync.Once
+}
+
+// NewBoundedRunner returns a Runner that invokes fn. Run requests fn to be
+// invoked; it will be invoked no sooner than minInterval after its previous
+// invocation. If no Run call arrives within maxInterval of the previous
+// invocation, fn is invoked anyway. The runner's internal scheduling
+// goroutine is started lazily, on the first call to Run, so that WithContext
+// and WithClock may be applied beforehand without racing with it.
+func NewBoundedRunner(fn func(), minInterval, maxInterval time.Duration) *Runner {
+	return &Runner{
+		ctx:         context.Background(),
+		clock:       NewRealClock(),
+		fn:          fn,
+		minInterval: minInterval,
+		maxInterval: maxInterval,
+		run:         make(chan struct{}, 1),
+		stop:        make(chan struct{}),
+	}
+}
+
+// WithContext adds a context.Context to the runner. If the context expires
+// then the runner will stop and fn will not be invoked again.
+func (r *Runner) WithContext(ctx context.Context) *Runner {
+	r.ctx = ctx
+	return r
+}
+
+// WithClock overrides the Clock used by the runner. It is primarily useful in
+// tests, where a fake Clock (such as timertest.FakeClock) can be injected in
+// place of NewRealClock() to deterministically control when fn is invoked.
+func (r *Runner) WithClock(c Clock) *Runner {
+	r.clock = c
+	return r
+}
+
+// Run requests that fn be invoked. It does not block, and any number of Run
+// calls that arrive before fn is actually invoked are coalesced into a single
+// invocation.
+func (r *Runner) Run() {
+	r.once.Do(func() { go r.loop() })
+	select {
+	case r.run <- struct{}{}:
+	default:
+	}
+}
+
+// Stop stops the runner. It returns true if it stopped the runner from
+// invoking fn again, and false if the runner was already stopped, or had
+// never been started. Stop does not block: if the runner's goroutine is not
+// waiting in its select at that moment (for instance, while fn is running),
+// Stop also returns false and the runner keeps running.
+func (r *Runner) Stop() bool {
+	select {
+	case r.stop <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// loop is the single goroutine that owns the runner's scheduling state. It
+// sleeps until the next legal fire time -- either the maxInterval deadline,
+// or a requested invocation once minInterval has elapsed -- and invokes fn
+// when it arrives.
+func (r *Runner) loop() {
+	// lastRun is initialized as though fn last ran minInterval ago, rather
+	// than at loop's start time, so that the first Run() is not throttled by
+	// minInterval before fn has ever been invoked.
+	lastRun := r.clock.Now().Add(-r.minInterval)
+	// deadline is left nil -- and so never selectable -- until fn has fired
+	// for the first time. There is no "previous invocation" for maxInterval
+	// to measure from before then, and the loop only starts once Run() has
+	// been called anyway, so arming it at startup would just register a
+	// Clock wait that every practical first Run() immediately orphans.
+	var deadline <-chan time.Time
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-r.stop:
+			return
+		case <-deadline:
+			select {
+			case <-r.run:
+			default:
+			}
+			r.fn()
+			lastRun = r.clock.Now()
+			deadline = r.clock.After(r.maxInterval)
+		case <-r.run:
+			wait := r.minInterval - r.clock.Now().Sub(lastRun)
+			if wait < 0 {
+				wait = 0
+			}
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-r.stop:
+				return
+			case <-deadline:
+				select {
+				case <-r.run:
+				default:
+				}
+				r.fn()
+				lastRun = r.clock.Now()
+				deadline = r.clock.After(r.maxInterval)
+			case <-r.clock.After(wait):
+				select {
+				case <-r.run:
+				default:
+				}
+				r.fn()
+				lastRun = r.clock.Now()
+				deadline = r.clock.After(r.maxInterval)
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package timer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tkennon/timer"
+	"github.com/tkennon/timer/timertest"
+)
+
+func TestRunner_FirstRunIsPrompt(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	calls := make(chan struct{}, 1)
+	r := timer.NewBoundedRunner(func() { calls <- struct{}{} }, time.Hour, time.Hour).WithClock(fc)
+
+	r.Run()
+
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("first Run() did not invoke fn promptly")
+	}
+}
+
+func TestRunner_CoalescesBurstyRuns(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	calls := make(chan struct{}, 10)
+	r := timer.NewBoundedRunner(func() { calls <- struct{}{} }, time.Minute, time.Hour).WithClock(fc)
+
+	for i := 0; i < 5; i++ {
+		r.Run()
+	}
+
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("bursty Run() calls did not invoke fn")
+	}
+	select {
+	case <-calls:
+		t.Fatal("bursty Run() calls coalesced into more than one invocation")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestRunner_MaxIntervalFiresWithoutFurtherRuns(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	calls := make(chan struct{}, 2)
+	r := timer.NewBoundedRunner(func() { calls <- struct{}{} }, 0, time.Second).WithClock(fc)
+
+	r.Run()
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("runner did not invoke fn on the first Run()")
+	}
+
+	// No further Run() calls arrive; the runner must still fire once
+	// maxInterval elapses.
+	fc.WaitForWaiters(1)
+	fc.Advance(time.Second)
+
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("runner did not invoke fn once maxInterval elapsed with no Run() calls")
+	}
+}
+
+func TestRunner_Stop(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+
+	never := timer.NewBoundedRunner(func() {}, time.Hour, time.Hour).WithClock(fc)
+	if never.Stop() {
+		t.Fatal("Stop() on a never-started runner returned true")
+	}
+
+	calls := make(chan struct{}, 1)
+	r := timer.NewBoundedRunner(func() { calls <- struct{}{} }, time.Hour, time.Hour).WithClock(fc)
+	r.Run()
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("runner did not invoke fn on the first Run()")
+	}
+
+	// Give the loop a moment to finish re-arming its deadline and settle back
+	// into its select, so Stop() is guaranteed to find it there to stop.
+	time.Sleep(10 * time.Millisecond)
+
+	if !r.Stop() {
+		t.Fatal("Stop() on a running runner returned false")
+	}
+	if r.Stop() {
+		t.Fatal("Stop() on an already-stopped runner returned true")
+	}
+}
+
+func TestRunner_WithContextCancellation(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := make(chan struct{}, 1)
+	r := timer.NewBoundedRunner(func() { calls <- struct{}{} }, time.Hour, time.Hour).WithClock(fc).WithContext(ctx)
+
+	r.Run()
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("runner did not invoke fn on the first Run()")
+	}
+
+	cancel()
+
+	// Once the context is canceled, the runner's loop exits, so Stop()
+	// eventually has nothing left to stop.
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if !r.Stop() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("runner did not exit after its context was canceled")
+}
@@ -0,0 +1,110 @@
+package timer
+
+import (
+	"sync"
+	"time"
+)
+
+// TickerOption configures the behaviour of a Ticker.
+type TickerOption func(*tickerConfig)
+
+type tickerConfig struct {
+	bufferSize int
+	drop       bool
+}
+
+// WithTickerBufferSize sets the size of the buffer on a Ticker's channel. The
+// default buffer size is 1.
+func WithTickerBufferSize(n int) TickerOption {
+	return func(c *tickerConfig) {
+		c.bufferSize = n
+	}
+}
+
+// WithTickerDrop causes a Ticker to drop a fire, rather than block its
+// driving goroutine, when the channel's buffer is full. The fires already in
+// the buffer are kept and the new fire is discarded. This mirrors the
+// behaviour of time.Ticker, and is useful when the consumer must never stall
+// the timer.
+func WithTickerDrop() TickerOption {
+	return func(c *tickerConfig) {
+		c.drop = true
+	}
+}
+
+// Ticker turns the timer into a continuous stream of fires, analogous to
+// time.NewTicker, but driven by this timer's interval (and any jitter,
+// min/max interval etc. already configured on it). It repeatedly calls Start
+// and republishes each fire on the returned channel, until the returned stop
+// function is called, the timer's context expires, or Start returns an error
+// (such as ErrMaxDurationElapsed). On any of the latter two, internal,
+// terminations the fire channel is closed and the cause is sent on the
+// returned error channel, so callers can safely range over the fire channel:
+//
+//	fires, errs, stop := t.Ticker()
+//	defer stop()
+//	for now := range fires {
+//		...
+//	}
+//	if err := <-errs; err != nil {
+//		...
+//	}
+//
+// Calling stop() itself sends a nil error. Callers must invoke the returned
+// stop function once they are done with the ticker, to release its driving
+// goroutine.
+func (t *Timer) Ticker(opts ...TickerOption) (<-chan time.Time, <-chan error, func()) {
+	cfg := tickerConfig{bufferSize: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	out := make(chan time.Time, cfg.bufferSize)
+	errs := make(chan error, 1)
+	done := make(chan struct{})
+	var once sync.Once
+	stop := func() {
+		once.Do(func() { close(done) })
+	}
+
+	go func() {
+		var err error
+		defer func() {
+			errs <- err
+			close(out)
+		}()
+
+		for {
+			c, startErr := t.Start()
+			if startErr != nil {
+				err = startErr
+				return
+			}
+			select {
+			case <-done:
+				return
+			case <-t.ctx.Done():
+				err = t.ctx.Err()
+				return
+			case now := <-c:
+				if cfg.drop {
+					select {
+					case out <- now:
+					default:
+					}
+					continue
+				}
+				select {
+				case out <- now:
+				case <-done:
+					return
+				case <-t.ctx.Done():
+					err = t.ctx.Err()
+					return
+				}
+			}
+		}
+	}()
+
+	return out, errs, stop
+}
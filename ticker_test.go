@@ -0,0 +1,170 @@
+package timer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tkennon/timer"
+	"github.com/tkennon/timer/timertest"
+)
+
+func TestTicker_WithTickerBufferSize(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	con := timer.NewConstant(time.Second).WithClock(fc)
+	out, _, stop := con.Ticker(timer.WithTickerBufferSize(2))
+	defer stop()
+
+	for i := 0; i < 2; i++ {
+		fc.WaitForWaiters(1)
+		fc.Advance(time.Second)
+	}
+
+	// Both fires must have been buffered without a reader draining them.
+	fc.WaitForWaiters(1)
+	if n := len(out); n != 2 {
+		t.Fatalf("want 2 buffered fires, got %d", n)
+	}
+}
+
+func TestTicker_BufferBlocksWhenFull(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	con := timer.NewConstant(time.Second).WithClock(fc)
+	out, _, stop := con.Ticker(timer.WithTickerBufferSize(1))
+	defer stop()
+
+	fc.WaitForWaiters(1)
+	fc.Advance(time.Second) // Fire #1 fills the buffer.
+
+	fc.WaitForWaiters(1)    // Fire #1 published; the next Start() is registered.
+	fc.Advance(time.Second) // Fire #2 arrives, but the buffer is still full.
+
+	// With nothing draining out, the driving goroutine must now be blocked
+	// publishing fire #2, so it never reaches its next Start() call.
+	registered := make(chan struct{})
+	go func() {
+		fc.WaitForWaiters(1)
+		close(registered)
+	}()
+	select {
+	case <-registered:
+		t.Fatal("ticker published into a full buffer without blocking")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Draining one value unblocks the publish.
+	<-out
+	select {
+	case <-registered:
+	case <-time.After(time.Second):
+		t.Fatal("ticker did not resume after the buffer was drained")
+	}
+}
+
+func TestTicker_WithTickerDrop(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	con := timer.NewConstant(time.Second).WithClock(fc)
+	out, _, stop := con.Ticker(timer.WithTickerDrop(), timer.WithTickerBufferSize(1))
+	defer stop()
+
+	fc.WaitForWaiters(1)
+	fc.Advance(time.Second) // Fire #1 fills the buffer.
+
+	for i := 0; i < 2; i++ {
+		// With nothing draining out, these fires must be dropped rather than
+		// block the driving goroutine, which should keep registering waits.
+		fc.WaitForWaiters(1)
+		fc.Advance(time.Second)
+	}
+	fc.WaitForWaiters(1)
+
+	if n := len(out); n != 1 {
+		t.Fatalf("want 1 buffered fire, got %d", n)
+	}
+}
+
+func TestTicker_Stop(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	con := timer.NewConstant(time.Second).WithClock(fc)
+	out, errs, stop := con.Ticker(timer.WithTickerBufferSize(2))
+
+	fc.WaitForWaiters(1)
+	fc.Advance(time.Second)
+	fc.WaitForWaiters(1) // Fire #1 published; the next Start() is registered.
+
+	stop()
+	stop() // Stop must be safe to call more than once.
+
+	<-out
+	fc.Advance(time.Second)
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("ticker produced a value after stop() was called")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ticker did not close its fire channel after stop() was called")
+	}
+
+	if err := <-errs; err != nil {
+		t.Fatalf("want nil error after stop(), got %v", err)
+	}
+}
+
+func TestTicker_StopsOnMaxDurationElapsed(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	con := timer.NewConstant(time.Second).WithClock(fc).WithMaxDuration(time.Second)
+	out, errs, stop := con.Ticker()
+	defer stop()
+
+	fc.WaitForWaiters(1)
+	fc.Advance(time.Second)
+
+	select {
+	case <-out:
+	case <-time.After(time.Second):
+		t.Fatal("ticker did not publish its only fire")
+	}
+
+	// The fire above consumed the whole max duration, so the ticker's next
+	// Start() call returns ErrMaxDurationElapsed. The fire channel must be
+	// closed and the error surfaced, so a `for range out` loop does not hang.
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("ticker published again after ErrMaxDurationElapsed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ticker did not close its fire channel after ErrMaxDurationElapsed")
+	}
+
+	if err := <-errs; err != timer.ErrMaxDurationElapsed {
+		t.Fatalf("want ErrMaxDurationElapsed, got %v", err)
+	}
+}
+
+func TestTicker_StopsOnContextCancellation(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+	con := timer.NewConstant(time.Second).WithClock(fc).WithContext(ctx)
+	out, errs, stop := con.Ticker()
+	defer stop()
+
+	fc.WaitForWaiters(1)
+	cancel()
+
+	// The fire channel must be closed and the context's error surfaced, so a
+	// `for range out` loop does not hang once the context is canceled.
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("ticker published a fire after its context was canceled")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ticker did not close its fire channel after its context was canceled")
+	}
+
+	if err := <-errs; err != context.Canceled {
+		t.Fatalf("want context.Canceled, got %v", err)
+	}
+}
@@ -3,18 +3,9 @@ package timer
 import (
 	"context"
 	"errors"
-	"math/rand"
 	"time"
 )
 
-// Functions stubbed out during testing.
-var (
-	// timeAfter does the actual sleeping.
-	timeAfter = time.After
-	// magnitude returns a uniformly random number in the range [-1.0, 1.0).
-	magnitude = func() float64 { return 1.0 - 2.0*rand.Float64() }
-)
-
 // Errors returned by the timer package.
 var (
 	// ErrMaxDurationElapsed is returned from (*Timer).Start() when the maximum
@@ -29,32 +20,51 @@ var (
 type interval interface {
 	next() time.Duration
 	reset()
+	base() time.Duration
 }
 
 // Timer is an object that sleeps.
 type Timer struct {
 	ctx            context.Context
+	clock          Clock
+	magnitude      func() float64
+	uniform        func() float64
 	interval       interval
 	total          time.Duration
 	jitter         float64
+	jitterMode     JitterMode
+	jitterCap      time.Duration
+	jitterPrev     time.Duration
 	minIntervalSet bool
 	minInterval    time.Duration
 	maxIntervalSet bool
 	maxInterval    time.Duration
 	maxDurationSet bool
 	maxDuration    time.Duration
+	lastInterval   time.Duration
 	stop           chan struct{}
 	f              func()
 }
 
 func newTimer(interval interval) *Timer {
 	return &Timer{
-		ctx:      context.Background(),
-		interval: interval,
-		stop:     make(chan struct{}),
+		ctx:       context.Background(),
+		clock:     NewRealClock(),
+		magnitude: magnitude,
+		uniform:   uniform,
+		interval:  interval,
+		stop:      make(chan struct{}),
 	}
 }
 
+// WithClock overrides the Clock used by the timer. It is primarily useful in
+// tests, where a fake Clock (such as timertest.FakeClock) can be injected in
+// place of NewRealClock() to deterministically control when the timer fires.
+func (t *Timer) WithClock(c Clock) *Timer {
+	t.clock = c
+	return t
+}
+
 // WithJitter adds a uniformly random jitter to the time the timer next fires.
 // The jitter will be within `fraction` of the current timer. e.g. if
 // WithJitter(0.2) is applied to an exponential timer that would otherwise fire
@@ -62,7 +72,8 @@ func newTimer(interval interval) *Timer {
 // seconds, and the second will fire between 1.6-2.4 seconds. The jitter
 // fraction may be greater than one, allowing the possible jittered timers to
 // fire immediately if the calculated interval with the jitter is less than
-// zero.
+// zero. WithJitter has no effect if WithJitterMode has selected a mode other
+// than JitterNone.
 func (t *Timer) WithJitter(fraction float64) *Timer {
 	t.jitter = fraction
 	return t
@@ -123,7 +134,7 @@ func (t *Timer) Start() (<-chan time.Time, error) {
 	next := t.interval.next()
 
 	// Add jitter.
-	next += time.Duration(t.jitter*magnitude()) * next
+	next = t.applyJitter(next)
 
 	// Floor a single interval.
 	if t.minIntervalSet && next < t.minInterval {
@@ -140,6 +151,7 @@ func (t *Timer) Start() (<-chan time.Time, error) {
 		return nil, ErrMaxDurationElapsed
 	}
 	t.total += next
+	t.lastInterval = next
 
 	// Asynchronously wait for the timer to expire.
 	ch := make(chan time.Time, 1)
@@ -149,7 +161,7 @@ func (t *Timer) Start() (<-chan time.Time, error) {
 			return
 		case <-t.stop:
 			return
-		case now := <-timeAfter(next):
+		case now := <-t.clock.After(next):
 			ch <- now
 			if t.f != nil {
 				t.f()
@@ -160,10 +172,18 @@ func (t *Timer) Start() (<-chan time.Time, error) {
 	return ch, nil
 }
 
+// LastInterval returns the interval used by the most recent call to Start.
+// It is primarily useful for observability, such as in Retry's OnRetry
+// callback.
+func (t *Timer) LastInterval() time.Duration {
+	return t.lastInterval
+}
+
 // Reset resets the timer to its initial interval, but retains all timer
 // configuration (such as jitter, max/min intervals etc).
 func (t *Timer) Reset() {
 	t.interval.reset()
+	t.jitterPrev = 0
 }
 
 // Stop stops the timer from firing. It returns true if it stopped the timer
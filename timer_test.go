@@ -10,8 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// clock is a type that is stubbed out for timeAfter so that we can easily
-// test the timer package.
+// clock is a Clock stub so that we can easily test the timer package.
 type clock struct {
 	next time.Duration
 	fire bool
@@ -21,7 +20,7 @@ func newClock() *clock {
 	return &clock{fire: true}
 }
 
-// After is a stub of time.After. It record the requested sleep duration and
+// After is a stub of time.After. It records the requested sleep duration and
 // immediately returns the current time.
 func (c *clock) After(next time.Duration) <-chan time.Time {
 	c.next = next
@@ -32,6 +31,11 @@ func (c *clock) After(next time.Duration) <-chan time.Time {
 	return ch
 }
 
+// Now is a stub of time.Now.
+func (c *clock) Now() time.Time {
+	return time.Now()
+}
+
 type prng struct {
 	val float64
 }
@@ -46,11 +50,8 @@ func (p *prng) Float64() float64 {
 
 func TestConstant(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 
-	constant := NewConstant(time.Second)
+	constant := NewConstant(time.Second).WithClock(fakeClock)
 	for trials := 0; trials < 2; trials++ {
 		for i := 0; i < 100; i++ {
 			c, err := constant.Start()
@@ -64,12 +65,9 @@ func TestConstant(t *testing.T) {
 
 func TestLinear(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 
 	initial, increment := time.Second, time.Second
-	linear := NewLinear(initial, increment)
+	linear := NewLinear(initial, increment).WithClock(fakeClock)
 	for trials := 0; trials < 2; trials++ {
 		for i := 0; i < 100; i++ {
 			c, err := linear.Start()
@@ -83,15 +81,12 @@ func TestLinear(t *testing.T) {
 
 func TestExponential(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 
 	// To avoid floating point errors in a large loop we must keep the exponent
 	// relatively low.
 	initial := time.Second
 	exponent := float32(1.1)
-	exponential := NewExponential(initial, exponent)
+	exponential := NewExponential(initial, exponent).WithClock(fakeClock)
 	for trials := 0; trials < 2; trials++ {
 		for i := 0; i < 100; i++ {
 			c, err := exponential.Start()
@@ -108,13 +103,7 @@ func TestExponential(t *testing.T) {
 
 func TestWithJitter(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 	fakePRNG := newPRNG()
-	ma := magnitude
-	defer func() { magnitude = ma }()
-	magnitude = fakePRNG.Float64
 	jitter := 0.1
 
 	tests := []struct {
@@ -126,7 +115,8 @@ func TestWithJitter(t *testing.T) {
 	}
 	for _, tt := range tests {
 		for _, val := range []float64{-1.0, 0.0, 1.0} {
-			timer := tt.timer.WithJitter(jitter)
+			timer := tt.timer.WithClock(fakeClock).WithJitter(jitter)
+			timer.magnitude = fakePRNG.Float64
 			timer.Reset()
 			fakePRNG.val = val
 			c, err := timer.Start()
@@ -140,9 +130,6 @@ func TestWithJitter(t *testing.T) {
 
 func TestWithMaxInterval(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 
 	maxInterval := time.Minute
 	tests := []struct {
@@ -153,7 +140,7 @@ func TestWithMaxInterval(t *testing.T) {
 		{NewExponential(time.Second, 3.0)},
 	}
 	for _, tt := range tests {
-		timer := tt.timer.WithMaxInterval(maxInterval)
+		timer := tt.timer.WithClock(fakeClock).WithMaxInterval(maxInterval)
 		for i := 0; i < 100; i++ {
 			c, err := timer.Start()
 			require.NoError(t, err)
@@ -165,9 +152,6 @@ func TestWithMaxInterval(t *testing.T) {
 
 func TestWithMinInterval(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 
 	minInterval := time.Second
 	tests := []struct {
@@ -178,7 +162,7 @@ func TestWithMinInterval(t *testing.T) {
 		{NewExponential(time.Minute, 0.1)},
 	}
 	for _, tt := range tests {
-		timer := tt.timer.WithMinInterval(minInterval)
+		timer := tt.timer.WithClock(fakeClock).WithMinInterval(minInterval)
 		for i := 0; i < 100; i++ {
 			c, err := timer.Start()
 			require.NoError(t, err)
@@ -190,9 +174,6 @@ func TestWithMinInterval(t *testing.T) {
 
 func TestWithMaxDuration(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 
 	maxDuration := time.Minute
 	tests := []struct {
@@ -203,7 +184,7 @@ func TestWithMaxDuration(t *testing.T) {
 		{NewExponential(time.Second, 2.0)},
 	}
 	for _, tt := range tests {
-		timer := tt.timer.WithMaxDuration(maxDuration)
+		timer := tt.timer.WithClock(fakeClock).WithMaxDuration(maxDuration)
 		for {
 			c, err := timer.Start()
 			if err == nil {
@@ -219,9 +200,6 @@ func TestWithMaxDuration(t *testing.T) {
 }
 
 func TestWithContext(t *testing.T) {
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-
 	tests := []struct {
 		timer *Timer
 	}{
@@ -231,9 +209,8 @@ func TestWithContext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		fakeClock := newClock()
-		timeAfter = fakeClock.After
 		ctx, cancel := context.WithCancel(context.Background())
-		timer := tt.timer.WithContext(ctx)
+		timer := tt.timer.WithClock(fakeClock).WithContext(ctx)
 
 		c, err := timer.Start()
 		require.NoError(t, err)
@@ -255,9 +232,6 @@ func TestWithContext(t *testing.T) {
 
 func TestWithFunc(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 
 	tests := []struct {
 		timer *Timer
@@ -268,7 +242,7 @@ func TestWithFunc(t *testing.T) {
 	}
 	for _, tt := range tests {
 		done := make(chan struct{})
-		timer := tt.timer.WithFunc(func() { close(done) })
+		timer := tt.timer.WithClock(fakeClock).WithFunc(func() { close(done) })
 		c, err := timer.Start()
 		require.NoError(t, err)
 		assert.NotEmpty(t, <-c)
@@ -277,9 +251,6 @@ func TestWithFunc(t *testing.T) {
 }
 
 func TestStop(t *testing.T) {
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-
 	tests := []struct {
 		timer *Timer
 	}{
@@ -289,30 +260,33 @@ func TestStop(t *testing.T) {
 	}
 	for _, tt := range tests {
 		fakeClock := newClock()
-		timeAfter = fakeClock.After
+		timer := tt.timer.WithClock(fakeClock)
 
-		_, err := tt.timer.Start()
+		c, err := timer.Start()
 		require.NoError(t, err)
-		stopped := tt.timer.Stop()
+		assert.NotEmpty(t, <-c)
+		stopped := timer.Stop()
 		assert.False(t, stopped)
 
 		fakeClock.fire = false
-		time.Sleep(10 * time.Millisecond)
 
-		_, err = tt.timer.Start()
+		_, err = timer.Start()
 		require.NoError(t, err)
-		stopped = tt.timer.Stop()
+
+		// Give the newly started timer's goroutine a moment to reach its
+		// select before Stop() tries to rendezvous with it.
+		time.Sleep(10 * time.Millisecond)
+
+		stopped = timer.Stop()
 		assert.True(t, stopped)
 	}
 }
 
 func TestInvalidSettings(t *testing.T) {
 	fakeClock := newClock()
-	ta := timeAfter
-	defer func() { timeAfter = ta }()
-	timeAfter = fakeClock.After
 
 	linear := NewConstant(time.Minute).
+		WithClock(fakeClock).
 		WithMaxInterval(time.Second).
 		WithMinInterval(time.Hour)
 
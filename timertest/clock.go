@@ -0,0 +1,97 @@
+// Package timertest provides test doubles for the timer package's Clock
+// interface.
+package timertest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tkennon/timer"
+)
+
+// FakeClock is a timer.Clock whose time only moves forward when Advance is
+// called. Injecting a FakeClock via WithClock lets tests deterministically
+// drive one or more Timers or Runners without sleeping in real time.
+//
+// A Timer or Runner registers its wait with the clock (via After) from a
+// goroutine it owns, asynchronously with respect to the call to Start or Run
+// that spawned it. Callers must therefore synchronize with WaitForWaiters
+// before calling Advance, otherwise Advance may run before the wait has been
+// registered and the Timer or Runner will never fire.
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	ch       chan time.Time
+}
+
+// NewFakeClock returns a FakeClock whose current time is set to start.
+func NewFakeClock(start time.Time) *FakeClock {
+	f := &FakeClock{now: start}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+// Now returns the clock's current, fake time.
+func (f *FakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// After returns a channel that fires with the clock's current time once the
+// clock has been Advanced by at least d.
+func (f *FakeClock) After(d time.Duration) <-chan time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- f.now
+		return ch
+	}
+
+	f.waiters = append(f.waiters, fakeWaiter{deadline: f.now.Add(d), ch: ch})
+	f.cond.Broadcast()
+	return ch
+}
+
+// WaitForWaiters blocks until at least n After calls are pending, that is,
+// registered with a positive duration and not yet fired by Advance. A pending
+// wait stays counted even if nothing receives from its channel any more.
+// Tests should call it before Advance whenever the wait they want
+// to trigger is registered by a goroutine they do not otherwise synchronize
+// with, such as a Timer's or Runner's internal goroutine.
+func (f *FakeClock) WaitForWaiters(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+// Advance moves the clock forward by d, firing any pending After channels
+// whose deadline has now elapsed.
+func (f *FakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+
+	pending := f.waiters[:0]
+	for _, w := range f.waiters {
+		if !w.deadline.After(f.now) {
+			w.ch <- f.now
+		} else {
+			pending = append(pending, w)
+		}
+	}
+	f.waiters = pending
+}
+
+var _ timer.Clock = (*FakeClock)(nil)
@@ -0,0 +1,54 @@
+package timertest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tkennon/timer"
+	"github.com/tkennon/timer/timertest"
+)
+
+func TestFakeClock_DrivesTimer(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+
+	con := timer.NewConstant(time.Second).WithClock(fc)
+	c, err := con.Start()
+	if err != nil {
+		t.Fatalf("Start() returned error: %v", err)
+	}
+
+	fc.WaitForWaiters(1)
+	fc.Advance(3 * time.Second)
+
+	select {
+	case <-c:
+	case <-time.After(time.Second):
+		t.Fatal("timer did not fire after the clock was advanced")
+	}
+}
+
+func TestFakeClock_DrivesRunner(t *testing.T) {
+	fc := timertest.NewFakeClock(time.Now())
+
+	calls := make(chan struct{}, 2)
+	r := timer.NewBoundedRunner(func() { calls <- struct{}{} }, 0, time.Second).WithClock(fc)
+
+	r.Run()
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("runner did not invoke fn on the first Run()")
+	}
+
+	// Once fn returns, the loop's internal goroutine arms its maxInterval
+	// deadline by calling clock.After. Synchronize with it before advancing, otherwise
+	// Advance could run before the wait is registered.
+	fc.WaitForWaiters(1)
+	fc.Advance(time.Second)
+
+	select {
+	case <-calls:
+	case <-time.After(time.Second):
+		t.Fatal("runner did not invoke fn again once maxInterval elapsed")
+	}
+}